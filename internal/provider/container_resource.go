@@ -0,0 +1,687 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/containers/common/pkg/manifest"
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+	"github.com/containers/podman/v5/pkg/specgen"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ContainerResource{}
+var _ resource.ResourceWithImportState = &ContainerResource{}
+
+func NewContainerResource() resource.Resource {
+	return &ContainerResource{}
+}
+
+// ContainerResource defines the resource implementation.
+type ContainerResource struct {
+	provider *ProviderData
+}
+
+// ContainerMountModel describes a single mount attached to a container.
+type ContainerMountModel struct {
+	Type    types.String `tfsdk:"type"`
+	Source  types.String `tfsdk:"source"`
+	Target  types.String `tfsdk:"target"`
+	Options types.List   `tfsdk:"options"`
+}
+
+// ContainerPortModel describes a single published port.
+type ContainerPortModel struct {
+	ContainerPort types.Int64  `tfsdk:"container_port"`
+	HostPort      types.Int64  `tfsdk:"host_port"`
+	HostIP        types.String `tfsdk:"host_ip"`
+	Protocol      types.String `tfsdk:"protocol"`
+}
+
+// ContainerHealthcheckModel describes the healthcheck attached to a container.
+type ContainerHealthcheckModel struct {
+	Test        types.List   `tfsdk:"test"`
+	Interval    types.String `tfsdk:"interval"`
+	Timeout     types.String `tfsdk:"timeout"`
+	Retries     types.Int64  `tfsdk:"retries"`
+	StartPeriod types.String `tfsdk:"start_period"`
+}
+
+// ContainerWaitForModel controls how long Create blocks for the container to
+// become healthy before giving up.
+type ContainerWaitForModel struct {
+	Healthy types.Bool   `tfsdk:"healthy"`
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+// ContainerResourceModel describes the resource data model.
+type ContainerResourceModel struct {
+	Id            types.String               `tfsdk:"id"`
+	Name          types.String               `tfsdk:"name"`
+	Image         types.String               `tfsdk:"image"`
+	Command       types.List                 `tfsdk:"command"`
+	Entrypoint    types.List                 `tfsdk:"entrypoint"`
+	Env           types.Map                  `tfsdk:"env"`
+	Mounts        []ContainerMountModel      `tfsdk:"mounts"`
+	Ports         []ContainerPortModel       `tfsdk:"ports"`
+	Networks      types.List                 `tfsdk:"networks"`
+	RestartPolicy types.String               `tfsdk:"restart_policy"`
+	Labels        types.Map                  `tfsdk:"labels"`
+	CapAdd        types.List                 `tfsdk:"cap_add"`
+	CapDrop       types.List                 `tfsdk:"cap_drop"`
+	SecurityOpt   types.List                 `tfsdk:"security_opt"`
+	User          types.String               `tfsdk:"user"`
+	WorkingDir    types.String               `tfsdk:"working_dir"`
+	Secrets       types.List                 `tfsdk:"secrets"`
+	Healthcheck   *ContainerHealthcheckModel `tfsdk:"healthcheck"`
+	WaitFor       *ContainerWaitForModel     `tfsdk:"wait_for"`
+	State         types.String               `tfsdk:"state"`
+	ExitCode      types.Int64                `tfsdk:"exit_code"`
+	Pid           types.Int64                `tfsdk:"pid"`
+	IPAddresses   types.Map                  `tfsdk:"ip_addresses"`
+	Connection    types.String               `tfsdk:"connection"`
+}
+
+func (r *ContainerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container"
+}
+
+func (r *ContainerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Podman container. Create, start, stop and remove a container from an image, optionally blocking on `wait_for` until its healthcheck reports healthy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"entrypoint": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"env": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"networks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"restart_policy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels to apply to the container. Changes are pushed in place via `containers.Update`, same as `restart_policy`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"cap_add": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"cap_drop": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_opt": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"working_dir": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secrets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"exit_code": schema.Int64Attribute{
+				Computed: true,
+			},
+			"pid": schema.Int64Attribute{
+				Computed: true,
+			},
+			"ip_addresses": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"mounts": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required: true,
+						},
+						"source": schema.StringAttribute{
+							Required: true,
+						},
+						"target": schema.StringAttribute{
+							Required: true,
+						},
+						"options": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"ports": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"container_port": schema.Int64Attribute{
+							Required: true,
+						},
+						"host_port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						"host_ip": schema.StringAttribute{
+							Optional: true,
+						},
+						"protocol": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"healthcheck": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"interval": schema.StringAttribute{
+						Optional: true,
+					},
+					"timeout": schema.StringAttribute{
+						Optional: true,
+					},
+					"retries": schema.Int64Attribute{
+						Optional: true,
+					},
+					"start_period": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"healthy": schema.BoolAttribute{
+						Optional: true,
+					},
+					"timeout": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ContainerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *ContainerResource) specFromModel(ctx context.Context, data *ContainerResourceModel) (*specgen.SpecGenerator, error) {
+	spec := specgen.NewSpecGenerator(data.Image.ValueString(), false)
+	spec.Name = data.Name.ValueString()
+	spec.User = data.User.ValueString()
+	spec.WorkDir = data.WorkingDir.ValueString()
+
+	if !data.Command.IsNull() {
+		if diag := data.Command.ElementsAs(ctx, &spec.Command, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid command")
+		}
+	}
+	if !data.Entrypoint.IsNull() {
+		if diag := data.Entrypoint.ElementsAs(ctx, &spec.Entrypoint, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid entrypoint")
+		}
+	}
+	if !data.Env.IsNull() {
+		env := map[string]string{}
+		if diag := data.Env.ElementsAs(ctx, &env, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid env")
+		}
+		spec.Env = env
+	}
+	if !data.Networks.IsNull() {
+		networks := []string{}
+		if diag := data.Networks.ElementsAs(ctx, &networks, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid networks")
+		}
+		spec.Networks = map[string]specgen.PortMappingWithNetwork{}
+		for _, n := range networks {
+			spec.Networks[n] = specgen.PortMappingWithNetwork{}
+		}
+	}
+	if !data.CapAdd.IsNull() {
+		if diag := data.CapAdd.ElementsAs(ctx, &spec.CapAdd, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid cap_add")
+		}
+	}
+	if !data.CapDrop.IsNull() {
+		if diag := data.CapDrop.ElementsAs(ctx, &spec.CapDrop, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid cap_drop")
+		}
+	}
+	if !data.SecurityOpt.IsNull() {
+		if diag := data.SecurityOpt.ElementsAs(ctx, &spec.SecurityOpt, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid security_opt")
+		}
+	}
+	if !data.RestartPolicy.IsNull() {
+		spec.RestartPolicy = data.RestartPolicy.ValueString()
+	} else {
+		spec.RestartPolicy = "no"
+	}
+	if !data.Labels.IsNull() {
+		labels := map[string]string{}
+		if diag := data.Labels.ElementsAs(ctx, &labels, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid labels")
+		}
+		spec.Labels = labels
+	}
+
+	for _, m := range data.Mounts {
+		options := []string{}
+		if !m.Options.IsNull() {
+			if diag := m.Options.ElementsAs(ctx, &options, false); diag.HasError() {
+				return nil, fmt.Errorf("invalid mount options")
+			}
+		}
+		spec.Mounts = append(spec.Mounts, specgen.MountConfig{
+			Type:        m.Type.ValueString(),
+			Source:      m.Source.ValueString(),
+			Destination: m.Target.ValueString(),
+			Options:     options,
+		})
+	}
+
+	for _, p := range data.Ports {
+		spec.PortMappings = append(spec.PortMappings, specgen.PortMapping{
+			ContainerPort: uint16(p.ContainerPort.ValueInt64()),
+			HostPort:      uint16(p.HostPort.ValueInt64()),
+			HostIP:        p.HostIP.ValueString(),
+			Protocol:      p.Protocol.ValueString(),
+		})
+	}
+
+	if data.Healthcheck != nil {
+		test := []string{}
+		if !data.Healthcheck.Test.IsNull() {
+			if diag := data.Healthcheck.Test.ElementsAs(ctx, &test, false); diag.HasError() {
+				return nil, fmt.Errorf("invalid healthcheck test")
+			}
+		}
+		spec.HealthConfig = &manifest.Schema2HealthConfig{
+			Test:    test,
+			Retries: int(data.Healthcheck.Retries.ValueInt64()),
+		}
+		if !data.Healthcheck.Interval.IsNull() {
+			d, err := time.ParseDuration(data.Healthcheck.Interval.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid healthcheck interval: %w", err)
+			}
+			spec.HealthConfig.Interval = d
+		}
+		if !data.Healthcheck.Timeout.IsNull() {
+			d, err := time.ParseDuration(data.Healthcheck.Timeout.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid healthcheck timeout: %w", err)
+			}
+			spec.HealthConfig.Timeout = d
+		}
+		if !data.Healthcheck.StartPeriod.IsNull() {
+			d, err := time.ParseDuration(data.Healthcheck.StartPeriod.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid healthcheck start_period: %w", err)
+			}
+			spec.HealthConfig.StartPeriod = d
+		}
+	}
+
+	if !data.Secrets.IsNull() {
+		names := []string{}
+		if diag := data.Secrets.ElementsAs(ctx, &names, false); diag.HasError() {
+			return nil, fmt.Errorf("invalid secrets")
+		}
+		for _, name := range names {
+			spec.Secrets = append(spec.Secrets, specgen.Secret{Source: name})
+		}
+	}
+
+	return spec, nil
+}
+
+func (r *ContainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ContainerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	spec, err := r.specFromModel(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to build container spec", err.Error())
+		return
+	}
+
+	createResp, err := containers.CreateWithSpec(conn, spec, &containers.CreateOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create container", err.Error())
+		return
+	}
+	data.Id = types.StringValue(createResp.ID)
+
+	if err := containers.Start(conn, createResp.ID, &containers.StartOptions{}); err != nil {
+		resp.Diagnostics.AddError("failed to start container", err.Error())
+		return
+	}
+
+	if data.WaitFor != nil {
+		timeout := 30 * time.Second
+		if !data.WaitFor.Timeout.IsNull() {
+			d, err := time.ParseDuration(data.WaitFor.Timeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("invalid wait_for timeout", err.Error())
+				return
+			}
+			timeout = d
+		}
+		if data.WaitFor.Healthy.ValueBool() {
+			if err := r.waitHealthy(conn, createResp.ID, timeout); err != nil {
+				resp.Diagnostics.AddError("container did not become healthy", err.Error())
+				return
+			}
+		}
+	}
+
+	inspect, err := containers.Inspect(conn, createResp.ID, &containers.InspectOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to inspect created container", err.Error())
+		return
+	}
+	diag := r.populateFromInspect(ctx, &data, inspect)
+	resp.Diagnostics.Append(diag...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContainerResource) waitHealthy(conn context.Context, id string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		inspect, err := containers.Inspect(conn, id, &containers.InspectOptions{})
+		if err != nil {
+			return err
+		}
+		if inspect.State.Health.Status == "healthy" {
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+	return fmt.Errorf("timed out after %s waiting for container %s to become healthy", timeout, id)
+}
+
+// populateFromInspect fills the computed attributes of data from a
+// containers.Inspect response. It is shared by Create and Read so both stay
+// in sync as new computed fields are added.
+func (r *ContainerResource) populateFromInspect(ctx context.Context, data *ContainerResourceModel, inspect *define.InspectContainerData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(inspect.Name)
+	data.State = types.StringValue(inspect.State.Status)
+	data.ExitCode = types.Int64Value(int64(inspect.State.ExitCode))
+	data.Pid = types.Int64Value(int64(inspect.State.Pid))
+
+	if inspect.HostConfig != nil && inspect.HostConfig.RestartPolicy != nil {
+		data.RestartPolicy = types.StringValue(inspect.HostConfig.RestartPolicy.Name)
+	}
+
+	for i := range data.Ports {
+		protocol := data.Ports[i].Protocol.ValueString()
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		data.Ports[i].Protocol = types.StringValue(protocol)
+
+		if inspect.HostConfig == nil {
+			continue
+		}
+		key := fmt.Sprintf("%d/%s", data.Ports[i].ContainerPort.ValueInt64(), protocol)
+		if bindings, ok := inspect.HostConfig.PortBindings[key]; ok && len(bindings) > 0 {
+			if hostPort, err := strconv.ParseInt(bindings[0].HostPort, 10, 64); err == nil {
+				data.Ports[i].HostPort = types.Int64Value(hostPort)
+			}
+		}
+	}
+
+	if inspect.Config != nil {
+		labelsValue, labelsDiag := basetypes.NewMapValueFrom(ctx, types.StringType, inspect.Config.Labels)
+		diags.Append(labelsDiag...)
+		data.Labels = labelsValue
+	}
+
+	ips := map[string]string{}
+	for netName, settings := range inspect.NetworkSettings.Networks {
+		ips[netName] = settings.IPAddress
+	}
+	ipValue, ipDiag := basetypes.NewMapValueFrom(ctx, types.StringType, ips)
+	diags.Append(ipDiag...)
+	data.IPAddresses = ipValue
+
+	return diags
+}
+
+func (r *ContainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ContainerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	inspect, err := containers.Inspect(conn, data.Id.ValueString(), &containers.InspectOptions{})
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.populateFromInspect(ctx, &data, inspect)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ContainerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(state.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	if !plan.RestartPolicy.Equal(state.RestartPolicy) {
+		restartRetries := uint(0)
+		if _, err := containers.Update(conn, state.Id.ValueString(), &entities.ContainerUpdateOptions{
+			Specgen: &specgen.SpecGenerator{
+				ContainerBasicConfig: specgen.ContainerBasicConfig{
+					RestartPolicy:  plan.RestartPolicy.ValueString(),
+					RestartRetries: &restartRetries,
+				},
+			},
+		}); err != nil {
+			resp.Diagnostics.AddError("failed to update restart policy", err.Error())
+			return
+		}
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		labels := map[string]string{}
+		if !plan.Labels.IsNull() {
+			if diag := plan.Labels.ElementsAs(ctx, &labels, false); diag.HasError() {
+				resp.Diagnostics.Append(diag...)
+				return
+			}
+		}
+		if _, err := containers.Update(conn, state.Id.ValueString(), &entities.ContainerUpdateOptions{
+			Specgen: &specgen.SpecGenerator{
+				ContainerBasicConfig: specgen.ContainerBasicConfig{
+					Labels: labels,
+				},
+			},
+		}); err != nil {
+			resp.Diagnostics.AddError("failed to update labels", err.Error())
+			return
+		}
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ContainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ContainerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	force := true
+	if _, err := containers.Remove(conn, data.Id.ValueString(), &containers.RemoveOptions{Force: &force}); err != nil {
+		resp.Diagnostics.AddError("failed to remove container", err.Error())
+		return
+	}
+}
+
+func (r *ContainerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
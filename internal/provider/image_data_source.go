@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v5/pkg/bindings/images"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImageDataSource{}
+
+func NewImageDataSource() datasource.DataSource {
+	return &ImageDataSource{}
+}
+
+// ImageDataSource defines the data source implementation.
+type ImageDataSource struct {
+	provider *ProviderData
+}
+
+// ImageDataSourceModel describes the data source data model.
+type ImageDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Id           types.String `tfsdk:"id"`
+	Labels       types.Map    `tfsdk:"labels"`
+	Architecture types.String `tfsdk:"architecture"`
+	Size         types.Int64  `tfsdk:"size"`
+	RepoDigests  types.List   `tfsdk:"repo_digests"`
+	Connection   types.String `tfsdk:"connection"`
+}
+
+func (d *ImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an already-present Podman image by reference, without pulling it.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"architecture": schema.StringAttribute{
+				Computed: true,
+			},
+			"size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"repo_digests": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *ImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = providerData
+}
+
+func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := d.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	inspect, err := images.GetImage(conn, data.Name.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to inspect image", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(inspect.ID)
+	data.Architecture = types.StringValue(inspect.Architecture)
+	data.Size = types.Int64Value(inspect.Size)
+
+	labels, diag := basetypes.NewMapValueFrom(ctx, types.StringType, inspect.Labels)
+	resp.Diagnostics.Append(diag...)
+	data.Labels = labels
+
+	repoDigests, diag := types.ListValueFrom(ctx, types.StringType, inspect.RepoDigests)
+	resp.Diagnostics.Append(diag...)
+	data.RepoDigests = repoDigests
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the function.Function interface.
+var _ function.Function = &ImageRefFunction{}
+
+func NewImageRefFunction() function.Function {
+	return &ImageRefFunction{}
+}
+
+// ImageRefFunction assembles a canonical image reference from its parts,
+// sparing module authors brittle string concatenation.
+type ImageRefFunction struct{}
+
+func (f *ImageRefFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "image_ref"
+}
+
+func (f *ImageRefFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Assemble a canonical Podman image reference",
+		Description: "Builds an image reference of the form [registry/]repository[:tag|@digest] from its parts, rejecting a reference that sets both tag and digest. An empty registry is omitted, which leaves the reference unqualified.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "registry",
+				MarkdownDescription: "Registry host, e.g. `docker.io`.",
+			},
+			function.StringParameter{
+				Name:                "repository",
+				MarkdownDescription: "Repository path, e.g. `library/nginx`.",
+			},
+			function.StringParameter{
+				Name:                "tag",
+				MarkdownDescription: "Tag, e.g. `latest`. Pass an empty string or `null` to omit.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "digest",
+				MarkdownDescription: "Digest, e.g. `sha256:...`. Pass an empty string or `null` to omit.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ImageRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var registry, repository string
+	var tagArg, digestArg types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &registry, &repository, &tagArg, &digestArg))
+	if resp.Error != nil {
+		return
+	}
+
+	// A null tag/digest means "omitted", same as an explicit empty string.
+	tag := tagArg.ValueString()
+	digest := digestArg.ValueString()
+
+	if tag != "" && digest != "" {
+		resp.Error = function.NewArgumentFuncError(2, "tag and digest cannot both be set on an image reference")
+		return
+	}
+
+	ref := repository
+	if registry != "" {
+		ref = fmt.Sprintf("%s/%s", registry, repository)
+	}
+	switch {
+	case tag != "":
+		ref = fmt.Sprintf("%s:%s", ref, tag)
+	case digest != "":
+		ref = fmt.Sprintf("%s@%s", ref, digest)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ref))
+}
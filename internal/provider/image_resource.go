@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v5/pkg/bindings/images"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ImageResource{}
+var _ resource.ResourceWithImportState = &ImageResource{}
+
+func NewImageResource() resource.Resource {
+	return &ImageResource{}
+}
+
+// ImageResource defines the resource implementation.
+type ImageResource struct {
+	provider *ProviderData
+}
+
+// ImageRegistryAuthModel describes the `registry_auth` block.
+type ImageRegistryAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	AuthFile types.String `tfsdk:"auth_file"`
+}
+
+// ImageResourceModel describes the resource data model.
+type ImageResourceModel struct {
+	Id           types.String            `tfsdk:"id"`
+	Name         types.String            `tfsdk:"name"`
+	PullPolicy   types.String            `tfsdk:"pull_policy"`
+	Platform     types.String            `tfsdk:"platform"`
+	RegistryAuth *ImageRegistryAuthModel `tfsdk:"registry_auth"`
+	ForceRemove  types.Bool              `tfsdk:"force_remove"`
+	Digest       types.String            `tfsdk:"digest"`
+	Connection   types.String            `tfsdk:"connection"`
+}
+
+func (r *ImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (r *ImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pulls and tracks a Podman image, so other resources such as `podman_container` can depend on `podman_image.foo.id` to guarantee the image is present before create.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resolved image ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Fully-qualified image reference to pull, e.g. `docker.io/library/nginx:latest`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pull_policy": schema.StringAttribute{
+				MarkdownDescription: "One of `always`, `missing`, `newer`, `never`. Defaults to `missing`. `always` and `newer` re-check the registry on every `terraform plan`/`apply`, not just when another attribute changes.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "`os/arch[/variant]` to pull, e.g. `linux/arm64`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_remove": schema.BoolAttribute{
+				MarkdownDescription: "Force-remove the image on destroy even if containers reference it.",
+				Optional:            true,
+			},
+			"digest": schema.StringAttribute{
+				Computed: true,
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"registry_auth": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"auth_file": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *ImageResource) pullOptions(data *ImageResourceModel) *images.PullOptions {
+	opts := new(images.PullOptions)
+
+	policy := "missing"
+	if !data.PullPolicy.IsNull() {
+		policy = data.PullPolicy.ValueString()
+	}
+	opts.WithPolicy(policy)
+
+	if !data.Platform.IsNull() {
+		opts.WithPlatform(data.Platform.ValueString())
+	}
+
+	if data.RegistryAuth != nil {
+		if !data.RegistryAuth.Username.IsNull() {
+			opts.WithUsername(data.RegistryAuth.Username.ValueString())
+		}
+		if !data.RegistryAuth.Password.IsNull() {
+			opts.WithPassword(data.RegistryAuth.Password.ValueString())
+		}
+		if !data.RegistryAuth.AuthFile.IsNull() {
+			opts.WithAuthfile(data.RegistryAuth.AuthFile.ValueString())
+		}
+	}
+
+	return opts
+}
+
+func (r *ImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	if data.PullPolicy.IsNull() {
+		data.PullPolicy = types.StringValue("missing")
+	}
+
+	pulled, err := images.Pull(conn, data.Name.ValueString(), r.pullOptions(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to pull image", err.Error())
+		return
+	}
+	if len(pulled) == 0 {
+		resp.Diagnostics.AddError("failed to pull image", "podman reported no images pulled for "+data.Name.ValueString())
+		return
+	}
+	data.Id = types.StringValue(pulled[0])
+
+	inspect, err := images.GetImage(conn, data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to inspect pulled image", err.Error())
+		return
+	}
+	data.Digest = types.StringValue(string(inspect.Digest))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ImageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	policy := data.PullPolicy.ValueString()
+	if policy == "always" || policy == "newer" {
+		// A local-store inspect alone would never notice a tag that moved on
+		// the registry, so re-pull on every refresh rather than waiting for
+		// Update, which only runs on an actual config diff.
+		pulled, err := images.Pull(conn, data.Name.ValueString(), r.pullOptions(&data))
+		if err != nil {
+			resp.Diagnostics.AddError("failed to re-pull image", err.Error())
+			return
+		}
+		if len(pulled) > 0 {
+			data.Id = types.StringValue(pulled[0])
+		}
+	}
+
+	inspect, err := images.GetImage(conn, data.Id.ValueString(), nil)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Digest = types.StringValue(string(inspect.Digest))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(state.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	if plan.PullPolicy.ValueString() == "always" || plan.PullPolicy.ValueString() == "newer" {
+		pulled, err := images.Pull(conn, plan.Name.ValueString(), r.pullOptions(&plan))
+		if err != nil {
+			resp.Diagnostics.AddError("failed to re-pull image", err.Error())
+			return
+		}
+		if len(pulled) > 0 {
+			state.Id = types.StringValue(pulled[0])
+		}
+	}
+
+	inspect, err := images.GetImage(conn, state.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to inspect image", err.Error())
+		return
+	}
+
+	plan.Id = state.Id
+	plan.Digest = types.StringValue(string(inspect.Digest))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ImageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	opts := new(images.RemoveOptions).WithForce(data.ForceRemove.ValueBool())
+	if _, errs := images.Remove(conn, []string{data.Id.ValueString()}, opts); len(errs) > 0 {
+		resp.Diagnostics.AddError("failed to remove image", errs[0].Error())
+		return
+	}
+}
+
+func (r *ImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
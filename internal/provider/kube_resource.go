@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v5/pkg/bindings/play"
+	"github.com/containers/podman/v5/pkg/bindings/pods"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KubeResource{}
+
+func NewKubeResource() resource.Resource {
+	return &KubeResource{}
+}
+
+// KubeResource defines the resource implementation.
+type KubeResource struct {
+	provider *ProviderData
+}
+
+// KubeResourceModel describes the resource data model.
+type KubeResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Yaml        types.String `tfsdk:"yaml"`
+	Network     types.List   `tfsdk:"network"`
+	Configmaps  types.List   `tfsdk:"configmaps"`
+	Build       types.Bool   `tfsdk:"build"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	Replace     types.Bool   `tfsdk:"replace"`
+	Pods        types.List   `tfsdk:"pods"`
+	Containers  types.List   `tfsdk:"containers"`
+	Connection  types.String `tfsdk:"connection"`
+}
+
+func (r *KubeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kube"
+}
+
+func (r *KubeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies a Kubernetes-style YAML manifest via Podman's `play kube`, materializing pods, containers, configmaps, and secrets without translating each workload into a `podman_container`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"yaml": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"configmaps": schema.ListAttribute{
+				MarkdownDescription: "Paths to configmap YAML files to apply alongside `yaml`. Only file paths are supported; inline configmap maps are not.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"build": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"annotations": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"replace": schema.BoolAttribute{
+				MarkdownDescription: "Passed through to `play kube --replace`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"pods": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"containers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *KubeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *KubeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KubeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	networks := []string{}
+	if !data.Network.IsNull() {
+		resp.Diagnostics.Append(data.Network.ElementsAs(ctx, &networks, false)...)
+	}
+	configmaps := []string{}
+	if !data.Configmaps.IsNull() {
+		resp.Diagnostics.Append(data.Configmaps.ElementsAs(ctx, &configmaps, false)...)
+	}
+	annotations := map[string]string{}
+	if !data.Annotations.IsNull() {
+		resp.Diagnostics.Append(data.Annotations.ElementsAs(ctx, &annotations, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := new(play.KubeOptions).
+		WithNetworks(networks).
+		WithConfigMaps(configmaps).
+		WithBuild(data.Build.ValueBool()).
+		WithAnnotations(annotations).
+		WithReplace(data.Replace.ValueBool())
+
+	report, err := play.Kube(conn, strings.NewReader(data.Yaml.ValueString()), opts)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to play kube manifest", err.Error())
+		return
+	}
+
+	var podIDs, containerIDs []string
+	for _, p := range report.Pods {
+		podIDs = append(podIDs, p.ID)
+		containerIDs = append(containerIDs, p.Containers...)
+	}
+	// The manifest itself has no single identity in Podman, so key state off
+	// the first pod it produced.
+	if len(podIDs) == 0 {
+		resp.Diagnostics.AddError("play kube produced no pods", "expected at least one pod from the manifest")
+		return
+	}
+	data.Id = types.StringValue(podIDs[0])
+
+	podsValue, diag := types.ListValueFrom(ctx, types.StringType, podIDs)
+	resp.Diagnostics.Append(diag...)
+	data.Pods = podsValue
+
+	containersValue, diag := types.ListValueFrom(ctx, types.StringType, containerIDs)
+	resp.Diagnostics.Append(diag...)
+	data.Containers = containersValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KubeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KubeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	var podIDs []string
+	resp.Diagnostics.Append(data.Pods.ElementsAs(ctx, &podIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var live []string
+	for _, id := range podIDs {
+		if _, err := pods.Inspect(conn, id, nil); err == nil {
+			live = append(live, id)
+		}
+	}
+
+	if len(live) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if len(live) != len(podIDs) {
+		// Some, but not all, of the manifest's pods have drifted away; force
+		// a recreate rather than silently tracking a partial deployment.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KubeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state KubeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every tracked attribute except `connection` forces replacement via
+	// RequiresReplace plan modifiers, so Update is only reached when
+	// `connection` alone changes. It only carries computed fields forward;
+	// the manifest's pods are not moved to the other connection.
+	plan.Id = state.Id
+	plan.Pods = state.Pods
+	plan.Containers = state.Containers
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KubeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KubeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	if _, err := play.Down(conn, strings.NewReader(data.Yaml.ValueString()), new(play.DownOptions)); err != nil {
+		resp.Diagnostics.AddError("failed to tear down kube manifest", err.Error())
+		return
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the function.Function interface.
+var _ function.Function = &MountFunction{}
+
+func NewMountFunction() function.Function {
+	return &MountFunction{}
+}
+
+// MountFunction builds an object matching the mount schema used by
+// podman_container's `mounts` block, so module authors can compose mounts
+// without hand-assembling the object literal.
+type MountFunction struct{}
+
+func (f *MountFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mount"
+}
+
+var mountAttributeTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"source":  types.StringType,
+	"target":  types.StringType,
+	"options": types.ListType{ElemType: types.StringType},
+}
+
+func (f *MountFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a podman_container mount object",
+		Description: "Returns an object matching the shape of a podman_container `mounts` block entry, for use with `dynamic \"mounts\"` blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "Mount type: `bind`, `volume`, or `tmpfs`.",
+			},
+			function.StringParameter{
+				Name:                "source",
+				MarkdownDescription: "Host path, volume name, or empty for `tmpfs`.",
+			},
+			function.StringParameter{
+				Name:                "target",
+				MarkdownDescription: "Path inside the container.",
+			},
+			function.ListParameter{
+				Name:                "options",
+				MarkdownDescription: "Mount options, e.g. `[\"ro\"]`.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: mountAttributeTypes,
+		},
+	}
+}
+
+func (f *MountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mountType, source, target string
+	var options types.List
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &mountType, &source, &target, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	switch mountType {
+	case "bind", "volume", "tmpfs":
+	default:
+		resp.Error = function.NewArgumentFuncError(0, "type must be one of \"bind\", \"volume\", or \"tmpfs\"")
+		return
+	}
+
+	result, diags := types.ObjectValue(mountAttributeTypes, map[string]attr.Value{
+		"type":    types.StringValue(mountType),
+		"source":  types.StringValue(source),
+		"target":  types.StringValue(target),
+		"options": options,
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/containers/podman/v5/pkg/bindings/network"
+	"github.com/containers/podman/v5/pkg/network/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tfstypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkResource{}
+var _ resource.ResourceWithImportState = &NetworkResource{}
+
+func NewNetworkResource() resource.Resource {
+	return &NetworkResource{}
+}
+
+// NetworkResource defines the resource implementation.
+type NetworkResource struct {
+	provider *ProviderData
+}
+
+// NetworkSubnetModel describes a single `subnets` entry.
+type NetworkSubnetModel struct {
+	Subnet  tfstypes.String `tfsdk:"subnet"`
+	Gateway tfstypes.String `tfsdk:"gateway"`
+}
+
+// NetworkResourceModel describes the resource data model.
+type NetworkResourceModel struct {
+	Id          tfstypes.String      `tfsdk:"id"`
+	Name        tfstypes.String      `tfsdk:"name"`
+	Driver      tfstypes.String      `tfsdk:"driver"`
+	Subnets     []NetworkSubnetModel `tfsdk:"subnets"`
+	IPv6Enabled tfstypes.Bool        `tfsdk:"ipv6_enabled"`
+	Internal    tfstypes.Bool        `tfsdk:"internal"`
+	DNSEnabled  tfstypes.Bool        `tfsdk:"dns_enabled"`
+	Options     tfstypes.Map         `tfsdk:"options"`
+	Labels      tfstypes.Map         `tfsdk:"labels"`
+	Connection  tfstypes.String      `tfsdk:"connection"`
+}
+
+func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Podman network, referenced symbolically by name from `podman_container`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"driver": schema.StringAttribute{
+				MarkdownDescription: "One of `bridge`, `macvlan`, `ipvlan`. Defaults to `bridge`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv6_enabled": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"internal": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_enabled": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.MapAttribute{
+				ElementType: tfstypes.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: tfstypes.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subnets": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"subnet": schema.StringAttribute{
+							Required: true,
+						},
+						"gateway": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *NetworkResource) toNetwork(ctx context.Context, data *NetworkResourceModel) (types.Network, error) {
+	spec := types.Network{
+		Name:        data.Name.ValueString(),
+		Driver:      data.Driver.ValueString(),
+		IPv6Enabled: data.IPv6Enabled.ValueBool(),
+		Internal:    data.Internal.ValueBool(),
+		DNSEnabled:  data.DNSEnabled.ValueBool(),
+	}
+
+	if !data.Options.IsNull() {
+		options := map[string]string{}
+		if diag := data.Options.ElementsAs(ctx, &options, false); diag.HasError() {
+			return spec, fmt.Errorf("invalid options")
+		}
+		spec.Options = options
+	}
+	if !data.Labels.IsNull() {
+		labels := map[string]string{}
+		if diag := data.Labels.ElementsAs(ctx, &labels, false); diag.HasError() {
+			return spec, fmt.Errorf("invalid labels")
+		}
+		spec.Labels = labels
+	}
+
+	for _, s := range data.Subnets {
+		subnet, err := parseSubnet(s.Subnet.ValueString(), s.Gateway.ValueString())
+		if err != nil {
+			return spec, err
+		}
+		spec.Subnets = append(spec.Subnets, subnet)
+	}
+
+	return spec, nil
+}
+
+func parseSubnet(cidr, gateway string) (types.Subnet, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return types.Subnet{}, fmt.Errorf("invalid subnet %q: %w", cidr, err)
+	}
+	subnet := types.Subnet{Subnet: types.IPNet{IPNet: *ipNet}}
+	if gateway != "" {
+		ip := net.ParseIP(gateway)
+		if ip == nil {
+			return types.Subnet{}, fmt.Errorf("invalid gateway %q", gateway)
+		}
+		subnet.Gateway = ip
+	}
+	return subnet, nil
+}
+
+func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	spec, err := r.toNetwork(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to build network spec", err.Error())
+		return
+	}
+
+	created, err := network.Create(conn, &spec)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create network", err.Error())
+		return
+	}
+
+	data.Id = tfstypes.StringValue(created.ID)
+	data.Driver = tfstypes.StringValue(created.Driver)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	inspect, err := network.Inspect(conn, data.Name.ValueString(), nil)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = tfstypes.StringValue(inspect.ID)
+	data.Driver = tfstypes.StringValue(inspect.Driver)
+	data.IPv6Enabled = tfstypes.BoolValue(inspect.IPv6Enabled)
+	data.Internal = tfstypes.BoolValue(inspect.Internal)
+	data.DNSEnabled = tfstypes.BoolValue(inspect.DNSEnabled)
+
+	labels, diag := basetypes.NewMapValueFrom(ctx, tfstypes.StringType, inspect.Labels)
+	resp.Diagnostics.Append(diag...)
+	data.Labels = labels
+
+	options, diag := basetypes.NewMapValueFrom(ctx, tfstypes.StringType, inspect.Options)
+	resp.Diagnostics.Append(diag...)
+	data.Options = options
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute except `connection` forces replacement via its plan
+	// modifiers, so Update is only reached when `connection` alone changes.
+	// It only carries computed fields forward; the network is not moved to
+	// the other connection.
+	plan.Id = state.Id
+	plan.Driver = state.Driver
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	if _, err := network.Remove(conn, data.Name.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError("failed to remove network", err.Error())
+		return
+	}
+}
+
+func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestParseSubnet(t *testing.T) {
+	tests := []struct {
+		name        string
+		cidr        string
+		gateway     string
+		wantErr     bool
+		wantGateway string
+	}{
+		{
+			name: "subnet without gateway",
+			cidr: "10.0.0.0/24",
+		},
+		{
+			name:        "subnet with gateway",
+			cidr:        "10.0.0.0/24",
+			gateway:     "10.0.0.1",
+			wantGateway: "10.0.0.1",
+		},
+		{
+			name:    "invalid cidr",
+			cidr:    "not-a-cidr",
+			wantErr: true,
+		},
+		{
+			name:    "invalid gateway",
+			cidr:    "10.0.0.0/24",
+			gateway: "not-an-ip",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnet, err := parseSubnet(tt.cidr, tt.gateway)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSubnet(%q, %q) = nil error, want error", tt.cidr, tt.gateway)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSubnet(%q, %q) unexpected error: %v", tt.cidr, tt.gateway, err)
+			}
+			if subnet.Subnet.String() != tt.cidr {
+				t.Errorf("subnet = %q, want %q", subnet.Subnet.String(), tt.cidr)
+			}
+			gotGateway := ""
+			if subnet.Gateway != nil {
+				gotGateway = subnet.Gateway.String()
+			}
+			if gotGateway != tt.wantGateway {
+				t.Errorf("gateway = %q, want %q", gotGateway, tt.wantGateway)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the function.Function interface.
+var _ function.Function = &ParseRefFunction{}
+
+func NewParseRefFunction() function.Function {
+	return &ParseRefFunction{}
+}
+
+// ParseRefFunction splits an image reference, such as one built by
+// ImageRefFunction, back into its parts. See parseImageRef for how the
+// registry is told apart from the namespace.
+type ParseRefFunction struct{}
+
+func (f *ParseRefFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_ref"
+}
+
+var parseRefAttributeTypes = map[string]attr.Type{
+	"registry":  types.StringType,
+	"namespace": types.StringType,
+	"name":      types.StringType,
+	"tag":       types.StringType,
+	"digest":    types.StringType,
+}
+
+func (f *ParseRefFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Podman image reference into its parts",
+		Description: "Splits a fully-qualified image reference into registry, namespace, name, tag, and digest. Any part not present in the reference is returned as an empty string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ref",
+				MarkdownDescription: "Image reference, e.g. `docker.io/library/nginx:latest`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseRefAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ref))
+	if resp.Error != nil {
+		return
+	}
+
+	registry, namespace, name, tag, digest := parseImageRef(ref)
+
+	result, diags := types.ObjectValue(parseRefAttributeTypes, map[string]attr.Value{
+		"registry":  types.StringValue(registry),
+		"namespace": types.StringValue(namespace),
+		"name":      types.StringValue(name),
+		"tag":       types.StringValue(tag),
+		"digest":    types.StringValue(digest),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseImageRef splits ref into registry, namespace, name, tag, and digest.
+// It's a light-weight parser rather than a full implementation of Docker's
+// reference grammar: the first path component is taken as the registry only
+// when it contains a '.' or ':', so "localhost/nginx" yields namespace
+// "localhost" and an empty registry.
+func parseImageRef(ref string) (registry, namespace, name, tag, digest string) {
+	path := ref
+
+	if idx := strings.Index(path, "@"); idx != -1 {
+		digest = path[idx+1:]
+		path = path[:idx]
+	} else if idx := strings.LastIndex(path, ":"); idx != -1 && !strings.Contains(path[idx:], "/") {
+		tag = path[idx+1:]
+		path = path[:idx]
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) > 1 && strings.ContainsAny(parts[0], ".:") {
+		registry = parts[0]
+		parts = parts[1:]
+	}
+
+	name = parts[len(parts)-1]
+	namespace = strings.Join(parts[:len(parts)-1], "/")
+
+	return registry, namespace, name, tag, digest
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestParseImageRef(t *testing.T) {
+	tests := []struct {
+		name          string
+		ref           string
+		wantRegistry  string
+		wantNamespace string
+		wantImage     string
+		wantTag       string
+		wantDigest    string
+	}{
+		{
+			name:      "bare name",
+			ref:       "nginx",
+			wantImage: "nginx",
+		},
+		{
+			name:      "name with tag",
+			ref:       "nginx:latest",
+			wantImage: "nginx",
+			wantTag:   "latest",
+		},
+		{
+			name:          "namespace and name",
+			ref:           "library/nginx",
+			wantNamespace: "library",
+			wantImage:     "nginx",
+		},
+		{
+			name:          "registry, namespace, name and tag",
+			ref:           "docker.io/library/nginx:latest",
+			wantRegistry:  "docker.io",
+			wantNamespace: "library",
+			wantImage:     "nginx",
+			wantTag:       "latest",
+		},
+		{
+			name:         "registry with port, no tag",
+			ref:          "myregistry:5000/nginx",
+			wantRegistry: "myregistry:5000",
+			wantImage:    "nginx",
+		},
+		{
+			name:         "registry with port and tag",
+			ref:          "myregistry:5000/nginx:latest",
+			wantRegistry: "myregistry:5000",
+			wantImage:    "nginx",
+			wantTag:      "latest",
+		},
+		{
+			name:      "digest instead of tag",
+			ref:       "nginx@sha256:deadbeef",
+			wantImage: "nginx",
+			wantDigest: "sha256:deadbeef",
+		},
+		{
+			name:          "registry, namespace, name and digest",
+			ref:           "docker.io/library/nginx@sha256:deadbeef",
+			wantRegistry:  "docker.io",
+			wantNamespace: "library",
+			wantImage:     "nginx",
+			wantDigest:    "sha256:deadbeef",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, namespace, name, tag, digest := parseImageRef(tt.ref)
+			if registry != tt.wantRegistry {
+				t.Errorf("registry = %q, want %q", registry, tt.wantRegistry)
+			}
+			if namespace != tt.wantNamespace {
+				t.Errorf("namespace = %q, want %q", namespace, tt.wantNamespace)
+			}
+			if name != tt.wantImage {
+				t.Errorf("name = %q, want %q", name, tt.wantImage)
+			}
+			if tag != tt.wantTag {
+				t.Errorf("tag = %q, want %q", tag, tt.wantTag)
+			}
+			if digest != tt.wantDigest {
+				t.Errorf("digest = %q, want %q", digest, tt.wantDigest)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/containers/podman/v5/pkg/bindings"
 
@@ -30,9 +31,43 @@ type PodmanProvider struct {
 	version string
 }
 
-// ScaffoldingProviderModel describes the provider data model.
+// PodmanProviderModel describes the provider data model.
 type PodmanProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
+	Endpoint    types.String            `tfsdk:"endpoint"`
+	Connections []PodmanConnectionModel `tfsdk:"connection"`
+}
+
+// PodmanConnectionModel describes a single named `connection` block. Mirrors
+// the shape of `podman system connection`, so a single Terraform config can
+// address several Podman hosts, including remote ones reached over SSH.
+type PodmanConnectionModel struct {
+	Name     types.String `tfsdk:"name"`
+	URI      types.String `tfsdk:"uri"`
+	Identity types.String `tfsdk:"identity"`
+	Default  types.Bool   `tfsdk:"default"`
+}
+
+// ProviderData is what's handed to resources and data sources via
+// resp.ResourceData / resp.DataSourceData. It carries every configured
+// connection so a resource can pick a non-default one via its own
+// `connection` attribute.
+type ProviderData struct {
+	Connections       map[string]context.Context
+	DefaultConnection string
+}
+
+// Connection resolves a resource's optional `connection` attribute to a
+// context.Context, falling back to the provider's default connection when
+// name is empty.
+func (d *ProviderData) Connection(name string) (context.Context, error) {
+	if name == "" {
+		name = d.DefaultConnection
+	}
+	conn, ok := d.Connections[name]
+	if !ok {
+		return nil, fmt.Errorf("no podman connection named %q is configured", name)
+	}
+	return conn, nil
 }
 
 func (p *PodmanProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,10 +79,33 @@ func (p *PodmanProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "Example provider attribute",
+				MarkdownDescription: "Deprecated: use a `connection` block instead. Unix socket to connect to when no `connection` blocks are configured.",
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"connection": schema.ListNestedBlock{
+				MarkdownDescription: "A named Podman connection, following the same model as `podman system connection`. Repeatable; resources select one by name via their own `connection` attribute, or fall back to whichever block sets `default = true`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"uri": schema.StringAttribute{
+							MarkdownDescription: "Connection URI, e.g. `unix:///run/podman/podman.sock`, `tcp://host:port`, or `ssh://user@host/run/podman/podman.sock`.",
+							Required:            true,
+						},
+						"identity": schema.StringAttribute{
+							MarkdownDescription: "Path to an SSH private key, used when `uri` is an `ssh://` URI. Only unencrypted keys are supported; passphrase-protected keys are rejected by the SSH dialer.",
+							Optional:            true,
+						},
+						"default": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -60,43 +118,90 @@ func (p *PodmanProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Configuration values are now available.
-	var endpoint string
-	if data.Endpoint.IsNull() {
-		dir, set := os.LookupEnv("XDG_RUNTIME_DIR")
-		if !set {
-			resp.Diagnostics.AddError("default endpoint cannot be used", "XDG_RUNTIME_DIR env var isn't set")
+	if len(data.Connections) == 0 {
+		var endpoint string
+		if data.Endpoint.IsNull() {
+			dir, set := os.LookupEnv("XDG_RUNTIME_DIR")
+			if !set {
+				resp.Diagnostics.AddError("default endpoint cannot be used", "XDG_RUNTIME_DIR env var isn't set")
+				return
+			}
+			endpoint = fmt.Sprintf(`unix:%s/podman/podman.sock`, dir)
+		} else {
+			endpoint = data.Endpoint.ValueString()
+		}
+
+		conn, err := bindings.NewConnection(context.Background(), endpoint)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to connect to podman socket", err.Error())
 			return
 		}
-		endpoint = fmt.Sprintf(`unix:%s/podman/podman.sock`, dir)
-	} else {
-		endpoint = data.Endpoint.ValueString()
-	}
 
-	conn, err := bindings.NewConnection(context.Background(), endpoint)
-	if err != nil {
-		resp.Diagnostics.AddError("failed to connect to podman socket", err.Error())
+		providerData := &ProviderData{
+			Connections:       map[string]context.Context{"default": conn},
+			DefaultConnection: "default",
+		}
+		resp.ResourceData = providerData
+		resp.DataSourceData = providerData
 		return
 	}
-	resp.ResourceData = conn
-	resp.DataSourceData = conn
+
+	providerData := &ProviderData{
+		Connections: map[string]context.Context{},
+	}
+
+	for _, c := range data.Connections {
+		name := c.Name.ValueString()
+		uri := c.URI.ValueString()
+
+		var (
+			conn context.Context
+			err  error
+		)
+		if strings.HasPrefix(uri, "ssh://") {
+			conn, err = bindings.NewConnectionWithIdentity(context.Background(), uri, c.Identity.ValueString(), false)
+		} else {
+			conn, err = bindings.NewConnection(context.Background(), uri)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to connect to podman connection %q", name), err.Error())
+			return
+		}
+
+		providerData.Connections[name] = conn
+		if c.Default.ValueBool() || providerData.DefaultConnection == "" {
+			providerData.DefaultConnection = name
+		}
+	}
+
+	resp.ResourceData = providerData
+	resp.DataSourceData = providerData
 }
 
 func (p *PodmanProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSecretResource,
+		NewContainerResource,
+		NewKubeResource,
+		NewImageResource,
+		NewVolumeResource,
+		NewNetworkResource,
 	}
 }
 
 func (p *PodmanProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewExampleDataSource,
+		NewImageDataSource,
 	}
 }
 
 func (p *PodmanProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewExampleFunction,
+		NewImageRefFunction,
+		NewMountFunction,
+		NewParseRefFunction,
 	}
 }
 
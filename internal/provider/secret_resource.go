@@ -5,18 +5,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/bindings/secrets"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -29,7 +31,7 @@ func NewSecretResource() resource.Resource {
 
 // SecretResource defines the resource implementation.
 type SecretResource struct {
-	conn context.Context
+	provider *ProviderData
 }
 
 // SecretResourceModel describes the resource data model.
@@ -40,6 +42,8 @@ type SecretResourceModel struct {
 	DriverOpts types.Map    `tfsdk:"driver_opts"`
 	Labels     types.Map    `tfsdk:"labels"`
 	Secret     types.String `tfsdk:"secret"`
+	SecretWO   types.String `tfsdk:"secret_wo"`
+	Connection types.String `tfsdk:"connection"`
 }
 
 func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,26 +52,51 @@ func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Secret",
+		MarkdownDescription: "Secret. Podman secrets are immutable: changing `name`, `driver`, `driver_opts`, `labels`, or `secret` destroys and recreates the secret rather than updating it in place.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"driver": schema.StringAttribute{
 				Computed: true,
 				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"labels": schema.MapAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
 			"driver_opts": schema.MapAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
 			"secret": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				MarkdownDescription: "Secret plaintext. Deprecated in favor of `secret_wo`, since this value is stored in state. Exactly one of `secret` or `secret_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Secret plaintext, write-only: never persisted to state or plan output. Exactly one of `secret` or `secret_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -75,6 +104,10 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -85,17 +118,43 @@ func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	conn, ok := req.ProviderData.(context.Context)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.conn = conn
+	r.provider = providerData
+}
+
+// secretPlaintext returns whichever of secret / secret_wo was set on data,
+// preferring the write-only variant. It's the caller's job to have already
+// validated that exactly one is set.
+func secretPlaintext(data *SecretResourceModel) string {
+	if !data.SecretWO.IsNull() {
+		return data.SecretWO.ValueString()
+	}
+	return data.Secret.ValueString()
+}
+
+func (r *SecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Secret.IsNull() == data.SecretWO.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid secret configuration",
+			"Exactly one of \"secret\" or \"secret_wo\" must be set.",
+		)
+	}
 }
 
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -118,7 +177,13 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	createResp, err := secrets.Create(r.conn, strings.NewReader(data.Secret.ValueString()), &secrets.CreateOptions{
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	createResp, err := secrets.Create(conn, strings.NewReader(secretPlaintext(&data)), &secrets.CreateOptions{
 		Name:       data.Name.ValueStringPointer(),
 		Driver:     data.Driver.ValueStringPointer(),
 		DriverOpts: driverOpts,
@@ -134,6 +199,9 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.Driver = types.StringValue("file")
 	}
 
+	// secret_wo is write-only and must never be written back to state.
+	data.SecretWO = types.StringNull()
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -146,58 +214,53 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	secret, err := secrets.List(r.conn, &secrets.ListOptions{
-		Filters: map[string][]string{
-			"id": {data.Id.ValueString()},
-		},
-	})
+	conn, err := r.provider.Connection(data.Connection.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("failed to get secret", err.Error())
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
 		return
 	}
 
-	if len(secret) == 0 {
-		resp.State.RemoveResource(ctx)
+	inspect, err := secrets.Inspect(conn, data.Id.ValueString(), nil)
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchSecret) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to get secret", err.Error())
 		return
 	}
 
-	data.Name = basetypes.NewStringValue(secret[0].Spec.Name)
-	data.Driver = basetypes.NewStringValue(secret[0].Spec.Driver.Name)
+	data.Name = basetypes.NewStringValue(inspect.Spec.Name)
+	data.Driver = basetypes.NewStringValue(inspect.Spec.Driver.Name)
 
-	driverOpts, diag := basetypes.NewMapValueFrom(ctx, types.StringType, secret[0].Spec.Driver.Options)
+	driverOpts, diag := basetypes.NewMapValueFrom(ctx, types.StringType, inspect.Spec.Driver.Options)
 	resp.Diagnostics.Append(diag...)
 	data.DriverOpts = driverOpts
 
-	labels, diag := basetypes.NewMapValueFrom(ctx, types.StringType, secret[0].Spec.Labels)
+	labels, diag := basetypes.NewMapValueFrom(ctx, types.StringType, inspect.Spec.Labels)
 	resp.Diagnostics.Append(diag...)
 	data.Labels = labels
 
-	data.Secret = basetypes.NewStringValue(secret[0].SecretData)
-	tflog.Error(ctx, fmt.Sprintf("secretdata: %q", secret[0].SecretData))
+	// The secret plaintext is intentionally never re-read from the daemon:
+	// doing so would round-trip it through state (or, for secret_wo, defeat
+	// the point of it being write-only) every refresh.
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every mutable-looking attribute (name, driver, driver_opts, labels,
+	// secret, secret_wo) carries RequiresReplace, so Update is only reached
+	// when `connection` alone changes. It then records the plan as is; the
+	// secret is not moved to the other connection.
 	var data SecretResourceModel
 
-	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
-
-	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -211,10 +274,18 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err := secrets.Remove(r.conn, data.Id.ValueString()); err != nil {
-		resp.Diagnostics.AddError("failed to delete secret", err.Error())
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
 		return
 	}
+
+	if err := secrets.Remove(conn, data.Id.ValueString()); err != nil {
+		if !errors.Is(err, define.ErrNoSuchSecret) {
+			resp.Diagnostics.AddError("failed to delete secret", err.Error())
+			return
+		}
+	}
 }
 
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecretPlaintext(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret types.String
+		wo     types.String
+		want   string
+	}{
+		{
+			name:   "secret only",
+			secret: types.StringValue("from-secret"),
+			wo:     types.StringNull(),
+			want:   "from-secret",
+		},
+		{
+			name:   "secret_wo only",
+			secret: types.StringNull(),
+			wo:     types.StringValue("from-secret-wo"),
+			want:   "from-secret-wo",
+		},
+		{
+			name:   "secret_wo preferred when both set",
+			secret: types.StringValue("from-secret"),
+			wo:     types.StringValue("from-secret-wo"),
+			want:   "from-secret-wo",
+		},
+		{
+			name:   "neither set",
+			secret: types.StringNull(),
+			wo:     types.StringNull(),
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &SecretResourceModel{Secret: tt.secret, SecretWO: tt.wo}
+			if got := secretPlaintext(data); got != tt.want {
+				t.Errorf("secretPlaintext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
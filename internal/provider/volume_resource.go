@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/podman/v5/pkg/bindings/volumes"
+	"github.com/containers/podman/v5/pkg/domain/entities"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VolumeResource{}
+var _ resource.ResourceWithImportState = &VolumeResource{}
+
+func NewVolumeResource() resource.Resource {
+	return &VolumeResource{}
+}
+
+// VolumeResource defines the resource implementation.
+type VolumeResource struct {
+	provider *ProviderData
+}
+
+// VolumeResourceModel describes the resource data model.
+type VolumeResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Driver     types.String `tfsdk:"driver"`
+	DriverOpts types.Map    `tfsdk:"driver_opts"`
+	Labels     types.Map    `tfsdk:"labels"`
+	Mountpoint types.String `tfsdk:"mountpoint"`
+	Connection types.String `tfsdk:"connection"`
+}
+
+func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume"
+}
+
+func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Podman volume, referenced symbolically by name from `podman_container`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"driver": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"driver_opts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"mountpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"connection": schema.StringAttribute{
+				MarkdownDescription: "Name of the provider `connection` block to use. Defaults to whichever connection is flagged `default = true`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = providerData
+}
+
+func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	driverOpts := map[string]string{}
+	labels := map[string]string{}
+	resp.Diagnostics.Append(data.DriverOpts.ElementsAs(ctx, &driverOpts, false)...)
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := volumes.Create(conn, entities.VolumeCreateOptions{
+		Name:    data.Name.ValueString(),
+		Driver:  data.Driver.ValueString(),
+		Options: driverOpts,
+		Label:   labels,
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create volume", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(created.Name)
+	data.Driver = types.StringValue(created.Driver)
+	data.Mountpoint = types.StringValue(created.Mountpoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	inspect, err := volumes.Inspect(conn, data.Name.ValueString(), nil)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Driver = types.StringValue(inspect.Driver)
+	data.Mountpoint = types.StringValue(inspect.Mountpoint)
+
+	driverOpts, diag := basetypes.NewMapValueFrom(ctx, types.StringType, inspect.Options)
+	resp.Diagnostics.Append(diag...)
+	data.DriverOpts = driverOpts
+
+	labels, diag := basetypes.NewMapValueFrom(ctx, types.StringType, inspect.Labels)
+	resp.Diagnostics.Append(diag...)
+	data.Labels = labels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VolumeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute except `connection` forces replacement via its plan
+	// modifiers, so Update is only reached when `connection` alone changes.
+	// It only carries computed fields forward; the volume is not moved to
+	// the other connection.
+	plan.Driver = state.Driver
+	plan.Mountpoint = state.Mountpoint
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.provider.Connection(data.Connection.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resolve podman connection", err.Error())
+		return
+	}
+
+	if err := volumes.Remove(conn, data.Name.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError("failed to remove volume", err.Error())
+		return
+	}
+}
+
+func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}